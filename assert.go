@@ -90,8 +90,11 @@ func NoError(t Tester, err error, msg ...interface{}) bool {
 	return true
 }
 
-// Equals checks that expected and actual are equal.
-func Equals(t Tester, expected, actual interface{}, msg ...interface{}) bool {
+// equalValues reports whether expected and actual are equal, applying the
+// same nil-aware rules as Equals: a nil-able value is considered equal to
+// an untyped nil, and two nil-able values of the same type are equal if
+// both are nil.
+func equalValues(expected, actual interface{}) bool {
 	if reflect.DeepEqual(expected, actual) {
 		return true
 	}
@@ -110,7 +113,22 @@ func Equals(t Tester, expected, actual interface{}, msg ...interface{}) bool {
 		}
 	}
 
+	return false
+}
+
+// Equals checks that expected and actual are equal.
+func Equals(t Tester, expected, actual interface{}, msg ...interface{}) bool {
+	if equalValues(expected, actual) {
+		return true
+	}
+
 	t.Helper()
+	if len(msg) == 0 {
+		if d := differ(expected, actual); d != "" {
+			reportError(t, []interface{}{fmt.Sprintf("values are not equal:\n%s", d)})
+			return false
+		}
+	}
 	reportError(t, message(msg, "'%v' and '%v' are not equal", expected, actual))
 	return false
 }
@@ -28,6 +28,8 @@ func (t *tester) Fatalf(format string, args ...interface{}) {
 	t.args = args
 }
 
+func (t *tester) Helper() {}
+
 func TestMessage(t *testing.T) {
 	args := []interface{}{"%s", "a message"}
 	if !reflect.DeepEqual(args, message(args, "default message")) {
@@ -0,0 +1,182 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// differ renders a human readable diff between two values that failed an
+// Equals check. SetDiffer lets callers plug in their own (for example one
+// backed by google/go-cmp); the default renders both values to a
+// canonical multi-line form and runs a line diff over the result.
+var differ = defaultDiffer
+
+// SetDiffer overrides the diff engine used to render Equals failures. f
+// receives the expected and actual values and returns the diff text to
+// include in the failure message, or the empty string to fall back to the
+// terse "are not equal" message.
+func SetDiffer(f func(a, b interface{}) string) {
+	differ = f
+}
+
+// defaultDiffer renders a and b to a canonical multi-line form and runs a
+// line diff over the result. If both values render on a single line, it
+// returns the empty string so Equals falls back to its terse message.
+func defaultDiffer(a, b interface{}) string {
+	ra, rb := render(a), render(b)
+	if !strings.Contains(ra, "\n") && !strings.Contains(rb, "\n") {
+		return ""
+	}
+	return lineDiff(ra, rb)
+}
+
+// render produces a canonical representation of v: strings are split as-is
+// on newlines, and everything else is walked with reflect into one line
+// per field or element, with map keys sorted for a stable order.
+func render(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	var b strings.Builder
+	renderValue(&b, reflect.ValueOf(v))
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func renderValue(b *strings.Builder, v reflect.Value) {
+	if !v.IsValid() {
+		b.WriteString("nil\n")
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			b.WriteString("nil\n")
+			return
+		}
+		renderValue(b, v.Elem())
+	case reflect.Struct:
+		fmt.Fprintf(b, "%s{\n", v.Type().Name())
+		for i := 0; i < v.NumField(); i++ {
+			fmt.Fprintf(b, "  %s: %s\n", v.Type().Field(i).Name, indent(render(safeInterface(v.Field(i)))))
+		}
+		b.WriteString("}\n")
+	case reflect.Map:
+		b.WriteString("map[\n")
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, k := range keys {
+			fmt.Fprintf(b, "  %v: %s\n", k.Interface(), indent(render(safeInterface(v.MapIndex(k)))))
+		}
+		b.WriteString("]\n")
+	case reflect.Slice, reflect.Array:
+		b.WriteString("[\n")
+		for i := 0; i < v.Len(); i++ {
+			fmt.Fprintf(b, "  %s\n", indent(render(safeInterface(v.Index(i)))))
+		}
+		b.WriteString("]\n")
+	default:
+		fmt.Fprintf(b, "%v\n", safeInterface(v))
+	}
+}
+
+// safeInterface returns v.Interface(), or v itself printed via Sprint if v
+// is an unexported struct field and cannot be interfaced directly.
+func safeInterface(v reflect.Value) interface{} {
+	if v.CanInterface() {
+		return v.Interface()
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// indent re-indents every line of s but the first by two spaces, so nested
+// renderings line up under their parent field or element.
+func indent(s string) string {
+	return strings.Join(strings.Split(s, "\n"), "\n  ")
+}
+
+type diffKind int
+
+const (
+	diffEqual diffKind = iota
+	diffDelete
+	diffInsert
+)
+
+type diffOp struct {
+	kind diffKind
+	line string
+}
+
+// lineDiff splits a and b into lines and renders a unified diff, marking
+// differing lines with a "-"/"+" prefix and unchanged lines with "  ".
+func lineDiff(a, b string) string {
+	ops := diffLines(strings.Split(a, "\n"), strings.Split(b, "\n"))
+	var out strings.Builder
+	for i, op := range ops {
+		if i > 0 {
+			out.WriteByte('\n')
+		}
+		switch op.kind {
+		case diffDelete:
+			out.WriteString("- " + op.line)
+		case diffInsert:
+			out.WriteString("+ " + op.line)
+		default:
+			out.WriteString("  " + op.line)
+		}
+	}
+	return out.String()
+}
+
+// diffLines computes a minimal line-based edit script between a and b.
+// It builds the standard longest-common-subsequence length table (the
+// edit graph a Myers diff walks) and backtracks it from (0,0), emitting a
+// match whenever the next lines agree and otherwise taking whichever of
+// the delete/insert edge keeps the remaining LCS longest.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{diffEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{diffDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{diffInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{diffDelete, a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{diffInsert, b[j]})
+	}
+	return ops
+}
@@ -0,0 +1,51 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultDiffer(t *testing.T) {
+	if d := defaultDiffer(1, 2); d != "" {
+		t.Errorf("expected no diff for single-line values, got %q", d)
+	}
+
+	d := defaultDiffer("foo\nbar\n", "foo\nbaz\n")
+	if !strings.Contains(d, "- bar") || !strings.Contains(d, "+ baz") {
+		t.Errorf("unexpected diff: %q", d)
+	}
+
+	type point struct{ X, Y int }
+	d = defaultDiffer(point{1, 2}, point{1, 3})
+	if !strings.Contains(d, "- ") || !strings.Contains(d, "+ ") {
+		t.Errorf("unexpected diff: %q", d)
+	}
+}
+
+func TestSetDiffer(t *testing.T) {
+	defer SetDiffer(defaultDiffer)
+
+	SetDiffer(func(a, b interface{}) string {
+		return "custom diff"
+	})
+
+	t1 := tt()
+	Equals(t1, 1, 2)
+	if !strings.Contains(t1.format, "custom diff") {
+		t.Errorf("expected custom differ to be used, got %q", t1.format)
+	}
+}
+
+func TestEquals_Diff(t *testing.T) {
+	t1 := tt()
+	Equals(t1, "foo\nbar", "foo\nbaz")
+	if !strings.Contains(t1.format, "- bar") {
+		t.Errorf("expected multi-line diff in message, got %q", t1.format)
+	}
+
+	t2 := tt()
+	Equals(t2, 1, 2, "custom message")
+	if !strings.Contains(t2.format, "custom message") {
+		t.Errorf("expected custom message to be preserved, got %q", t2.format)
+	}
+}
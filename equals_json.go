@@ -0,0 +1,69 @@
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// EqualsJSON checks that expected and actual represent the same JSON
+// document. An operand that is a string, []byte or json.RawMessage is
+// unmarshaled directly; anything else is round-tripped through
+// json.Marshal/json.Unmarshal first. Comparing the normalized values this
+// way means map key ordering, integer-vs-float representation and
+// whitespace differences do not cause a spurious failure.
+func EqualsJSON(t Tester, expected, actual interface{}, msg ...interface{}) bool {
+	ne, err := normalizeJSON(expected)
+	if err != nil {
+		t.Helper()
+		reportError(t, message(msg, "expected value is not valid JSON: %s", err))
+		return false
+	}
+	na, err := normalizeJSON(actual)
+	if err != nil {
+		t.Helper()
+		reportError(t, message(msg, "actual value is not valid JSON: %s", err))
+		return false
+	}
+
+	if reflect.DeepEqual(ne, na) {
+		return true
+	}
+
+	t.Helper()
+	eb, _ := json.MarshalIndent(ne, "", "  ")
+	ab, _ := json.MarshalIndent(na, "", "  ")
+	if len(msg) == 0 {
+		reportError(t, []interface{}{fmt.Sprintf("JSON values are not equal:\n%s", lineDiff(string(eb), string(ab)))})
+		return false
+	}
+	reportError(t, message(msg, "'%s' and '%s' are not equal", eb, ab))
+	return false
+}
+
+// normalizeJSON unmarshals v into an interface{} tree suitable for
+// reflect.DeepEqual comparison, marshaling it to JSON first if it isn't
+// already JSON text.
+func normalizeJSON(v interface{}) (interface{}, error) {
+	var raw []byte
+	switch t := v.(type) {
+	case string:
+		raw = []byte(t)
+	case []byte:
+		raw = t
+	case json.RawMessage:
+		raw = t
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		raw = b
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,31 @@
+package assert
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEqualsJSON(t *testing.T) {
+	type obj struct {
+		Foo string `json:"foo"`
+		Bar int    `json:"bar"`
+	}
+
+	tests := []struct {
+		expected, actual interface{}
+		res              bool
+	}{
+		{`{"foo":"a","bar":1}`, `{"bar":1,"foo":"a"}`, true},
+		{`{"foo":"a","bar":1}`, `{"bar": 1.0, "foo": "a"}`, true},
+		{obj{"a", 1}, `{"foo":"a","bar":1}`, true},
+		{[]byte(`{"foo":"a","bar":1}`), json.RawMessage(`{"bar":1,"foo":"a"}`), true},
+		{`{"foo":"a"}`, `{"foo":"b"}`, false},
+		{`not json`, `{}`, false},
+	}
+
+	for i, tc := range tests {
+		if EqualsJSON(tt(), tc.expected, tc.actual) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.expected, tc.actual)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package assert
+
+import (
+	"math"
+	"reflect"
+	"time"
+)
+
+// compareNumeric compares a and b, both of which must be one of the
+// built-in numeric kinds (any signed or unsigned integer width, or a
+// float32/float64). It returns -1, 0 or 1 following the usual comparison
+// convention, and ok is false if a and b are not comparable numeric kinds.
+func compareNumeric(a, b interface{}) (cmp int, ok bool) {
+	va := reflect.ValueOf(a)
+	vb := reflect.ValueOf(b)
+
+	switch {
+	case isIntKind(va.Kind()) && isIntKind(vb.Kind()):
+		x, y := va.Int(), vb.Int()
+		return compareInt64(x, y), true
+	case isUintKind(va.Kind()) && isUintKind(vb.Kind()):
+		x, y := va.Uint(), vb.Uint()
+		return compareUint64(x, y), true
+	case isFloatKind(va.Kind()) && isFloatKind(vb.Kind()):
+		x, y := va.Float(), vb.Float()
+		return compareFloat64(x, y), true
+	case isFloatKind(va.Kind()) && isIntKind(vb.Kind()):
+		return compareFloat64(va.Float(), float64(vb.Int())), true
+	case isIntKind(va.Kind()) && isFloatKind(vb.Kind()):
+		return compareFloat64(float64(va.Int()), vb.Float()), true
+	case isFloatKind(va.Kind()) && isUintKind(vb.Kind()):
+		return compareFloat64(va.Float(), float64(vb.Uint())), true
+	case isUintKind(va.Kind()) && isFloatKind(vb.Kind()):
+		return compareFloat64(float64(va.Uint()), vb.Float()), true
+	case isIntKind(va.Kind()) && isUintKind(vb.Kind()):
+		return compareFloat64(float64(va.Int()), float64(vb.Uint())), true
+	case isUintKind(va.Kind()) && isIntKind(vb.Kind()):
+		return compareFloat64(float64(va.Uint()), float64(vb.Int())), true
+	default:
+		return 0, false
+	}
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUintKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Greater checks that a is greater than b. a and b must be one of the
+// built-in numeric kinds.
+func Greater(t Tester, a, b interface{}, msg ...interface{}) bool {
+	cmp, ok := compareNumeric(a, b)
+	if !ok {
+		t.Helper()
+		reportError(t, message(msg, "cannot compare '%T' and '%T'", a, b))
+		return false
+	}
+	if cmp > 0 {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%v' is not greater than '%v'", a, b))
+	return false
+}
+
+// GreaterOrEqual checks that a is greater than or equal to b. a and b must
+// be one of the built-in numeric kinds.
+func GreaterOrEqual(t Tester, a, b interface{}, msg ...interface{}) bool {
+	cmp, ok := compareNumeric(a, b)
+	if !ok {
+		t.Helper()
+		reportError(t, message(msg, "cannot compare '%T' and '%T'", a, b))
+		return false
+	}
+	if cmp >= 0 {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%v' is not greater than or equal to '%v'", a, b))
+	return false
+}
+
+// Less checks that a is less than b. a and b must be one of the built-in
+// numeric kinds.
+func Less(t Tester, a, b interface{}, msg ...interface{}) bool {
+	cmp, ok := compareNumeric(a, b)
+	if !ok {
+		t.Helper()
+		reportError(t, message(msg, "cannot compare '%T' and '%T'", a, b))
+		return false
+	}
+	if cmp < 0 {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%v' is not less than '%v'", a, b))
+	return false
+}
+
+// LessOrEqual checks that a is less than or equal to b. a and b must be
+// one of the built-in numeric kinds.
+func LessOrEqual(t Tester, a, b interface{}, msg ...interface{}) bool {
+	cmp, ok := compareNumeric(a, b)
+	if !ok {
+		t.Helper()
+		reportError(t, message(msg, "cannot compare '%T' and '%T'", a, b))
+		return false
+	}
+	if cmp <= 0 {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%v' is not less than or equal to '%v'", a, b))
+	return false
+}
+
+// Between checks that v is within [low, high]. low, high and v must be one
+// of the built-in numeric kinds.
+func Between(t Tester, low, high, v interface{}, msg ...interface{}) bool {
+	cmpLow, ok := compareNumeric(v, low)
+	if !ok {
+		t.Helper()
+		reportError(t, message(msg, "cannot compare '%T' and '%T'", v, low))
+		return false
+	}
+	cmpHigh, ok := compareNumeric(v, high)
+	if !ok {
+		t.Helper()
+		reportError(t, message(msg, "cannot compare '%T' and '%T'", v, high))
+		return false
+	}
+	if cmpLow >= 0 && cmpHigh <= 0 {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%v' is not between '%v' and '%v'", v, low, high))
+	return false
+}
+
+// InDelta checks that expected and actual are within delta of each other.
+// A NaN or an infinite delta never matches, and expected and actual only
+// match when both are NaN, both are the same infinity, or their absolute
+// difference is at most delta.
+func InDelta(t Tester, expected, actual, delta float64, msg ...interface{}) bool {
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		t.Helper()
+		reportError(t, message(msg, "delta '%v' must be a finite, non-NaN number", delta))
+		return false
+	}
+
+	switch {
+	case math.IsNaN(expected) && math.IsNaN(actual):
+		return true
+	case math.IsNaN(expected) || math.IsNaN(actual):
+		t.Helper()
+		reportError(t, message(msg, "'%v' and '%v' are not within '%v'", expected, actual, delta))
+		return false
+	case math.IsInf(expected, 0) || math.IsInf(actual, 0):
+		if expected == actual {
+			return true
+		}
+		t.Helper()
+		reportError(t, message(msg, "'%v' and '%v' are not within '%v'", expected, actual, delta))
+		return false
+	}
+
+	if math.Abs(expected-actual) <= delta {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%v' and '%v' are not within '%v'", expected, actual, delta))
+	return false
+}
+
+// InDuration checks that expected and actual are within delta of each
+// other.
+func InDuration(t Tester, expected, actual time.Time, delta time.Duration, msg ...interface{}) bool {
+	diff := expected.Sub(actual)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff <= delta {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%v' and '%v' are not within '%v'", expected, actual, delta))
+	return false
+}
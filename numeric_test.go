@@ -0,0 +1,141 @@
+package assert
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGreater(t *testing.T) {
+	tests := []struct {
+		a, b interface{}
+		res  bool
+	}{
+		{2, 1, true},
+		{1, 2, false},
+		{1, 1, false},
+		{uint(2), uint(1), true},
+		{2.5, 2.4, true},
+		{2, 1.5, true},
+		{"a", "b", false},
+	}
+
+	for i, tc := range tests {
+		if Greater(tt(), tc.a, tc.b) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.a, tc.b)
+		}
+	}
+}
+
+func TestGreaterOrEqual(t *testing.T) {
+	tests := []struct {
+		a, b interface{}
+		res  bool
+	}{
+		{2, 1, true},
+		{1, 1, true},
+		{1, 2, false},
+		{int8(1), int8(1), true},
+	}
+
+	for i, tc := range tests {
+		if GreaterOrEqual(tt(), tc.a, tc.b) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.a, tc.b)
+		}
+	}
+}
+
+func TestLess(t *testing.T) {
+	tests := []struct {
+		a, b interface{}
+		res  bool
+	}{
+		{1, 2, true},
+		{2, 1, false},
+		{1, 1, false},
+	}
+
+	for i, tc := range tests {
+		if Less(tt(), tc.a, tc.b) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.a, tc.b)
+		}
+	}
+}
+
+func TestLessOrEqual(t *testing.T) {
+	tests := []struct {
+		a, b interface{}
+		res  bool
+	}{
+		{1, 2, true},
+		{1, 1, true},
+		{2, 1, false},
+	}
+
+	for i, tc := range tests {
+		if LessOrEqual(tt(), tc.a, tc.b) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.a, tc.b)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		low, high, v interface{}
+		res          bool
+	}{
+		{1, 10, 5, true},
+		{1, 10, 1, true},
+		{1, 10, 10, true},
+		{1, 10, 0, false},
+		{1, 10, 11, false},
+		{1.0, 10.0, 5, true},
+	}
+
+	for i, tc := range tests {
+		if Between(tt(), tc.low, tc.high, tc.v) != tc.res {
+			t.Errorf("test %d with %v failed", i, tc.v)
+		}
+	}
+}
+
+func TestInDelta(t *testing.T) {
+	tests := []struct {
+		expected, actual, delta float64
+		res                     bool
+	}{
+		{1.0, 1.05, 0.1, true},
+		{1.0, 1.2, 0.1, false},
+		{1.0, 1.0, 0, true},
+		{math.NaN(), math.NaN(), 0.1, true},
+		{math.NaN(), 1.0, 0.1, false},
+		{math.Inf(1), math.Inf(1), 0.1, true},
+		{math.Inf(1), math.Inf(-1), 0.1, false},
+	}
+
+	for i, tc := range tests {
+		if InDelta(tt(), tc.expected, tc.actual, tc.delta) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.expected, tc.actual)
+		}
+	}
+}
+
+func TestInDuration(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		expected, actual time.Time
+		delta            time.Duration
+		res              bool
+	}{
+		{now, now, time.Second, true},
+		{now, now.Add(time.Second), 2 * time.Second, true},
+		{now, now.Add(time.Second), 500 * time.Millisecond, false},
+		{now.Add(time.Second), now, 2 * time.Second, true},
+	}
+
+	for i, tc := range tests {
+		if InDuration(tt(), tc.expected, tc.actual, tc.delta) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.expected, tc.actual)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package assert
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// PanicWith checks that f panics with a value equal to expected, using the
+// same nil-aware equality as Equals so that both panic("boom") and
+// panic(errors.New("boom")) can be asserted against their matching
+// expected value.
+func PanicWith(t Tester, expected interface{}, f func(), msg ...interface{}) (ret bool) {
+	t.Helper()
+	var recovered interface{}
+	var panicked bool
+	func() {
+		defer func() {
+			recovered = recover()
+			panicked = recovered != nil
+		}()
+		f()
+	}()
+
+	if !panicked {
+		t.Helper()
+		reportError(t, message(msg, "function did not panic"))
+		return false
+	}
+	if equalValues(expected, recovered) {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "function panicked with '%v', '%v' expected", recovered, expected))
+	return false
+}
+
+// PanicMatches checks that f panics with a value whose string
+// representation matches pattern, compiled as a regular expression.
+func PanicMatches(t Tester, pattern string, f func(), msg ...interface{}) (ret bool) {
+	t.Helper()
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Helper()
+		reportError(t, message(msg, "pattern '%s' is not a valid regexp: %s", pattern, err))
+		return false
+	}
+
+	var recovered interface{}
+	var panicked bool
+	func() {
+		defer func() {
+			recovered = recover()
+			panicked = recovered != nil
+		}()
+		f()
+	}()
+
+	if !panicked {
+		t.Helper()
+		reportError(t, message(msg, "function did not panic"))
+		return false
+	}
+	if re.MatchString(fmt.Sprint(recovered)) {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "function panicked with '%v', which does not match pattern '%s'", recovered, pattern))
+	return false
+}
@@ -0,0 +1,50 @@
+package assert
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPanicWith(t *testing.T) {
+	t1 := tt()
+	if !PanicWith(t1, "boom", func() { panic("boom") }) || t1.method != "" {
+		t.Fail()
+	}
+
+	t2 := tt()
+	if PanicWith(t2, "boom", func() { panic("bang") }) || t2.method != "Errorf" {
+		t.Fail()
+	}
+
+	t3 := tt()
+	if PanicWith(t3, "boom", func() {}) || t3.method != "Errorf" {
+		t.Fail()
+	}
+
+	t4 := tt()
+	if !PanicWith(t4, errors.New("boom"), func() { panic(errors.New("boom")) }) || t4.method != "" {
+		t.Fail()
+	}
+}
+
+func TestPanicMatches(t *testing.T) {
+	t1 := tt()
+	if !PanicMatches(t1, "^bo", func() { panic("boom") }) || t1.method != "" {
+		t.Fail()
+	}
+
+	t2 := tt()
+	if PanicMatches(t2, "^ba", func() { panic("boom") }) || t2.method != "Errorf" {
+		t.Fail()
+	}
+
+	t3 := tt()
+	if PanicMatches(t3, "^bo", func() {}) || t3.method != "Errorf" {
+		t.Fail()
+	}
+
+	t4 := tt()
+	if PanicMatches(t4, "[", func() { panic("boom") }) || t4.method != "Errorf" {
+		t.Fail()
+	}
+}
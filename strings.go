@@ -0,0 +1,98 @@
+package assert
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Contains checks that haystack contains needle.
+func Contains(t Tester, haystack, needle string, msg ...interface{}) bool {
+	if strings.Contains(haystack, needle) {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%s' does not contain '%s'", haystack, needle))
+	return false
+}
+
+// NotContains checks that haystack does not contain needle.
+func NotContains(t Tester, haystack, needle string, msg ...interface{}) bool {
+	if !strings.Contains(haystack, needle) {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%s' contains '%s'", haystack, needle))
+	return false
+}
+
+// HasSuffix checks that the string contains the given suffix.
+func HasSuffix(t Tester, s, suf string, msg ...interface{}) bool {
+	if strings.HasSuffix(s, suf) {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%s' is not a suffix of '%s'", suf, s))
+	return false
+}
+
+// Match checks that pattern, compiled as a regular expression, matches s.
+// It reports a failure, rather than panicking, if pattern fails to
+// compile.
+func Match(t Tester, pattern, s string, msg ...interface{}) bool {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Helper()
+		reportError(t, message(msg, "pattern '%s' is not a valid regexp: %s", pattern, err))
+		return false
+	}
+	if re.MatchString(s) {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%s' does not match pattern '%s'", s, pattern))
+	return false
+}
+
+// SliceContains checks that slice, which must be a slice or an array,
+// contains elem.
+func SliceContains(t Tester, slice, elem interface{}, msg ...interface{}) bool {
+	v := reflect.ValueOf(slice)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if reflect.DeepEqual(v.Index(i).Interface(), elem) {
+				return true
+			}
+		}
+		t.Helper()
+		reportError(t, message(msg, "'%v' does not contain '%v'", slice, elem))
+		return false
+	default:
+		t.Helper()
+		reportError(t, message(msg, "cannot apply SliceContains to '%s' (%v)", v.Kind(), slice))
+		return false
+	}
+}
+
+// MapContainsKey checks that m, which must be a map, contains key.
+func MapContainsKey(t Tester, m, key interface{}, msg ...interface{}) bool {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Map {
+		t.Helper()
+		reportError(t, message(msg, "cannot apply MapContainsKey to '%s' (%v)", v.Kind(), m))
+		return false
+	}
+	kv := reflect.ValueOf(key)
+	if !kv.IsValid() || !kv.Type().AssignableTo(v.Type().Key()) {
+		t.Helper()
+		reportError(t, message(msg, "'%v' does not contain key '%v'", m, key))
+		return false
+	}
+	if v.MapIndex(kv).IsValid() {
+		return true
+	}
+	t.Helper()
+	reportError(t, message(msg, "'%v' does not contain key '%v'", m, key))
+	return false
+}
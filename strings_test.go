@@ -0,0 +1,107 @@
+package assert
+
+import "testing"
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		haystack, needle string
+		res              bool
+	}{
+		{"foobar", "oob", true},
+		{"foobar", "baz", false},
+		{"", "", true},
+	}
+
+	for i, tc := range tests {
+		if Contains(tt(), tc.haystack, tc.needle) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.haystack, tc.needle)
+		}
+	}
+}
+
+func TestNotContains(t *testing.T) {
+	tests := []struct {
+		haystack, needle string
+		res              bool
+	}{
+		{"foobar", "oob", false},
+		{"foobar", "baz", true},
+	}
+
+	for i, tc := range tests {
+		if NotContains(tt(), tc.haystack, tc.needle) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.haystack, tc.needle)
+		}
+	}
+}
+
+func TestHasSuffix(t *testing.T) {
+	tests := []struct {
+		s, suf string
+		res    bool
+	}{
+		{"foobar", "bar", true},
+		{"foobar", "foo", false},
+	}
+
+	for i, tc := range tests {
+		if HasSuffix(tt(), tc.s, tc.suf) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.s, tc.suf)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern, s string
+		res        bool
+	}{
+		{"^foo", "foobar", true},
+		{"^bar", "foobar", false},
+		{"[", "foobar", false},
+	}
+
+	for i, tc := range tests {
+		if Match(tt(), tc.pattern, tc.s) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.pattern, tc.s)
+		}
+	}
+}
+
+func TestSliceContains(t *testing.T) {
+	tests := []struct {
+		slice, elem interface{}
+		res         bool
+	}{
+		{[]int{1, 2, 3}, 2, true},
+		{[]int{1, 2, 3}, 4, false},
+		{[3]string{"a", "b", "c"}, "b", true},
+		{1234, 1, false},
+	}
+
+	for i, tc := range tests {
+		if SliceContains(tt(), tc.slice, tc.elem) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.slice, tc.elem)
+		}
+	}
+}
+
+func TestMapContainsKey(t *testing.T) {
+	m := map[string]int{"foo": 1, "bar": 2}
+	tests := []struct {
+		m, key interface{}
+		res    bool
+	}{
+		{m, "foo", true},
+		{m, "baz", false},
+		{m, 1, false},
+		{m, nil, false},
+		{1234, "foo", false},
+	}
+
+	for i, tc := range tests {
+		if MapContainsKey(tt(), tc.m, tc.key) != tc.res {
+			t.Errorf("test %d with %v and %v failed", i, tc.m, tc.key)
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+// T wraps a *testing.T and exposes every checker in this package as a
+// method, so tests can write t.Equals(a, b) instead of assert.Equals(t, a,
+// b). It embeds *testing.T, so the regular *testing.T methods (Fatalf, Log,
+// Run, ...) remain available on it. The exception is Error, which T
+// redefines as the error checker; testing.T's own Error is reachable as
+// t.T.Error.
+type T struct {
+	*testing.T
+
+	// Must exposes the same checkers as T, but upgrades a failure to
+	// Fatalf semantics, stopping the test immediately instead of just
+	// marking it failed. It replaces the need for the separate Fatal and
+	// FatalError functions.
+	Must *MustT
+}
+
+// New returns a *T wrapping tt, ready to use as a drop-in replacement for
+// *testing.T with fluent assertion methods.
+func New(tt *testing.T) *T {
+	return &T{
+		T:    tt,
+		Must: &MustT{tt},
+	}
+}
+
+// True checks that a condition is true.
+func (t *T) True(condition bool, msg ...interface{}) bool {
+	t.Helper()
+	return True(t.T, condition, msg...)
+}
+
+// False checks that a condition is false.
+func (t *T) False(condition bool, msg ...interface{}) bool {
+	t.Helper()
+	return False(t.T, condition, msg...)
+}
+
+// Error checks if err is not nil.
+func (t *T) Error(err error, msg ...interface{}) bool {
+	t.Helper()
+	return Error(t.T, err, msg...)
+}
+
+// NoError checks if err is nil.
+func (t *T) NoError(err error, msg ...interface{}) bool {
+	t.Helper()
+	return NoError(t.T, err, msg...)
+}
+
+// Equals checks that expected and actual are equal.
+func (t *T) Equals(expected, actual interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return Equals(t.T, expected, actual, msg...)
+}
+
+// NotEquals checks that expected and actual are not equal.
+func (t *T) NotEquals(expected, actual interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return NotEquals(t.T, expected, actual, msg...)
+}
+
+// Nil checks that the value is nil.
+func (t *T) Nil(value interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return Nil(t.T, value, msg...)
+}
+
+// NotNil checks that the value is not nil.
+func (t *T) NotNil(value interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return NotNil(t.T, value, msg...)
+}
+
+// Len checks that the application of len() to value match the expected
+// value.
+func (t *T) Len(expected int, value interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return Len(t.T, expected, value, msg...)
+}
+
+// Panic checks that the passed function panics.
+func (t *T) Panic(f func(), msg ...interface{}) bool {
+	t.Helper()
+	return Panic(t.T, f, msg...)
+}
+
+// Type checks that the value matches the type of expected.
+func (t *T) Type(expected, value interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return Type(t.T, expected, value, msg...)
+}
+
+// HasPrefix checks that the string contains the given prefix.
+func (t *T) HasPrefix(s, p string, msg ...interface{}) bool {
+	t.Helper()
+	return HasPrefix(t.T, s, p, msg...)
+}
+
+// Greater checks that a is greater than b.
+func (t *T) Greater(a, b interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return Greater(t.T, a, b, msg...)
+}
+
+// GreaterOrEqual checks that a is greater than or equal to b.
+func (t *T) GreaterOrEqual(a, b interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return GreaterOrEqual(t.T, a, b, msg...)
+}
+
+// Less checks that a is less than b.
+func (t *T) Less(a, b interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return Less(t.T, a, b, msg...)
+}
+
+// LessOrEqual checks that a is less than or equal to b.
+func (t *T) LessOrEqual(a, b interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return LessOrEqual(t.T, a, b, msg...)
+}
+
+// Between checks that v is within [low, high].
+func (t *T) Between(low, high, v interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return Between(t.T, low, high, v, msg...)
+}
+
+// InDelta checks that expected and actual are within delta of each other.
+func (t *T) InDelta(expected, actual, delta float64, msg ...interface{}) bool {
+	t.Helper()
+	return InDelta(t.T, expected, actual, delta, msg...)
+}
+
+// InDuration checks that expected and actual are within delta of each
+// other.
+func (t *T) InDuration(expected, actual time.Time, delta time.Duration, msg ...interface{}) bool {
+	t.Helper()
+	return InDuration(t.T, expected, actual, delta, msg...)
+}
+
+// Contains checks that haystack contains needle.
+func (t *T) Contains(haystack, needle string, msg ...interface{}) bool {
+	t.Helper()
+	return Contains(t.T, haystack, needle, msg...)
+}
+
+// NotContains checks that haystack does not contain needle.
+func (t *T) NotContains(haystack, needle string, msg ...interface{}) bool {
+	t.Helper()
+	return NotContains(t.T, haystack, needle, msg...)
+}
+
+// HasSuffix checks that the string contains the given suffix.
+func (t *T) HasSuffix(s, suf string, msg ...interface{}) bool {
+	t.Helper()
+	return HasSuffix(t.T, s, suf, msg...)
+}
+
+// Match checks that pattern, compiled as a regular expression, matches s.
+func (t *T) Match(pattern, s string, msg ...interface{}) bool {
+	t.Helper()
+	return Match(t.T, pattern, s, msg...)
+}
+
+// SliceContains checks that slice, which must be a slice or an array,
+// contains elem.
+func (t *T) SliceContains(slice, elem interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return SliceContains(t.T, slice, elem, msg...)
+}
+
+// MapContainsKey checks that m, which must be a map, contains key.
+func (t *T) MapContainsKey(m, key interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return MapContainsKey(t.T, m, key, msg...)
+}
+
+// EqualsJSON checks that expected and actual represent the same JSON
+// document.
+func (t *T) EqualsJSON(expected, actual interface{}, msg ...interface{}) bool {
+	t.Helper()
+	return EqualsJSON(t.T, expected, actual, msg...)
+}
+
+// PanicWith checks that f panics with a value equal to expected.
+func (t *T) PanicWith(expected interface{}, f func(), msg ...interface{}) bool {
+	t.Helper()
+	return PanicWith(t.T, expected, f, msg...)
+}
+
+// PanicMatches checks that f panics with a value whose string
+// representation matches pattern.
+func (t *T) PanicMatches(pattern string, f func(), msg ...interface{}) bool {
+	t.Helper()
+	return PanicMatches(t.T, pattern, f, msg...)
+}
+
+// fatalTester adapts a Tester so that a checker failure reported through
+// Errorf is escalated to Fatalf, stopping the test immediately.
+type fatalTester struct {
+	Tester
+}
+
+func (t fatalTester) Errorf(format string, args ...interface{}) {
+	t.Tester.Helper()
+	t.Tester.Fatalf(format, args...)
+}
+
+// MustT is the Must-flavored counterpart of T: every checker upgrades a
+// failure to Fatalf semantics instead of Errorf semantics.
+type MustT struct {
+	t *testing.T
+}
+
+// True checks that a condition is true, or stops the test.
+func (m *MustT) True(condition bool, msg ...interface{}) {
+	m.t.Helper()
+	True(fatalTester{m.t}, condition, msg...)
+}
+
+// False checks that a condition is false, or stops the test.
+func (m *MustT) False(condition bool, msg ...interface{}) {
+	m.t.Helper()
+	False(fatalTester{m.t}, condition, msg...)
+}
+
+// Error checks if err is not nil, or stops the test.
+func (m *MustT) Error(err error, msg ...interface{}) {
+	m.t.Helper()
+	Error(fatalTester{m.t}, err, msg...)
+}
+
+// NoError checks if err is nil, or stops the test.
+func (m *MustT) NoError(err error, msg ...interface{}) {
+	m.t.Helper()
+	NoError(fatalTester{m.t}, err, msg...)
+}
+
+// Equals checks that expected and actual are equal, or stops the test.
+func (m *MustT) Equals(expected, actual interface{}, msg ...interface{}) {
+	m.t.Helper()
+	Equals(fatalTester{m.t}, expected, actual, msg...)
+}
+
+// NotEquals checks that expected and actual are not equal, or stops the
+// test.
+func (m *MustT) NotEquals(expected, actual interface{}, msg ...interface{}) {
+	m.t.Helper()
+	NotEquals(fatalTester{m.t}, expected, actual, msg...)
+}
+
+// Nil checks that the value is nil, or stops the test.
+func (m *MustT) Nil(value interface{}, msg ...interface{}) {
+	m.t.Helper()
+	Nil(fatalTester{m.t}, value, msg...)
+}
+
+// NotNil checks that the value is not nil, or stops the test.
+func (m *MustT) NotNil(value interface{}, msg ...interface{}) {
+	m.t.Helper()
+	NotNil(fatalTester{m.t}, value, msg...)
+}
+
+// Len checks that the application of len() to value match the expected
+// value, or stops the test.
+func (m *MustT) Len(expected int, value interface{}, msg ...interface{}) {
+	m.t.Helper()
+	Len(fatalTester{m.t}, expected, value, msg...)
+}
+
+// Panic checks that the passed function panics, or stops the test.
+func (m *MustT) Panic(f func(), msg ...interface{}) {
+	m.t.Helper()
+	Panic(fatalTester{m.t}, f, msg...)
+}
+
+// Type checks that the value matches the type of expected, or stops the
+// test.
+func (m *MustT) Type(expected, value interface{}, msg ...interface{}) {
+	m.t.Helper()
+	Type(fatalTester{m.t}, expected, value, msg...)
+}
+
+// HasPrefix checks that the string contains the given prefix, or stops the
+// test.
+func (m *MustT) HasPrefix(s, p string, msg ...interface{}) {
+	m.t.Helper()
+	HasPrefix(fatalTester{m.t}, s, p, msg...)
+}
+
+// Greater checks that a is greater than b, or stops the test.
+func (m *MustT) Greater(a, b interface{}, msg ...interface{}) {
+	m.t.Helper()
+	Greater(fatalTester{m.t}, a, b, msg...)
+}
+
+// GreaterOrEqual checks that a is greater than or equal to b, or stops the
+// test.
+func (m *MustT) GreaterOrEqual(a, b interface{}, msg ...interface{}) {
+	m.t.Helper()
+	GreaterOrEqual(fatalTester{m.t}, a, b, msg...)
+}
+
+// Less checks that a is less than b, or stops the test.
+func (m *MustT) Less(a, b interface{}, msg ...interface{}) {
+	m.t.Helper()
+	Less(fatalTester{m.t}, a, b, msg...)
+}
+
+// LessOrEqual checks that a is less than or equal to b, or stops the test.
+func (m *MustT) LessOrEqual(a, b interface{}, msg ...interface{}) {
+	m.t.Helper()
+	LessOrEqual(fatalTester{m.t}, a, b, msg...)
+}
+
+// Between checks that v is within [low, high], or stops the test.
+func (m *MustT) Between(low, high, v interface{}, msg ...interface{}) {
+	m.t.Helper()
+	Between(fatalTester{m.t}, low, high, v, msg...)
+}
+
+// InDelta checks that expected and actual are within delta of each other,
+// or stops the test.
+func (m *MustT) InDelta(expected, actual, delta float64, msg ...interface{}) {
+	m.t.Helper()
+	InDelta(fatalTester{m.t}, expected, actual, delta, msg...)
+}
+
+// InDuration checks that expected and actual are within delta of each
+// other, or stops the test.
+func (m *MustT) InDuration(expected, actual time.Time, delta time.Duration, msg ...interface{}) {
+	m.t.Helper()
+	InDuration(fatalTester{m.t}, expected, actual, delta, msg...)
+}
+
+// Contains checks that haystack contains needle, or stops the test.
+func (m *MustT) Contains(haystack, needle string, msg ...interface{}) {
+	m.t.Helper()
+	Contains(fatalTester{m.t}, haystack, needle, msg...)
+}
+
+// NotContains checks that haystack does not contain needle, or stops the
+// test.
+func (m *MustT) NotContains(haystack, needle string, msg ...interface{}) {
+	m.t.Helper()
+	NotContains(fatalTester{m.t}, haystack, needle, msg...)
+}
+
+// HasSuffix checks that the string contains the given suffix, or stops the
+// test.
+func (m *MustT) HasSuffix(s, suf string, msg ...interface{}) {
+	m.t.Helper()
+	HasSuffix(fatalTester{m.t}, s, suf, msg...)
+}
+
+// Match checks that pattern, compiled as a regular expression, matches s,
+// or stops the test.
+func (m *MustT) Match(pattern, s string, msg ...interface{}) {
+	m.t.Helper()
+	Match(fatalTester{m.t}, pattern, s, msg...)
+}
+
+// SliceContains checks that slice, which must be a slice or an array,
+// contains elem, or stops the test.
+func (m *MustT) SliceContains(slice, elem interface{}, msg ...interface{}) {
+	m.t.Helper()
+	SliceContains(fatalTester{m.t}, slice, elem, msg...)
+}
+
+// MapContainsKey checks that m, which must be a map, contains key, or
+// stops the test.
+func (m *MustT) MapContainsKey(mp, key interface{}, msg ...interface{}) {
+	m.t.Helper()
+	MapContainsKey(fatalTester{m.t}, mp, key, msg...)
+}
+
+// EqualsJSON checks that expected and actual represent the same JSON
+// document, or stops the test.
+func (m *MustT) EqualsJSON(expected, actual interface{}, msg ...interface{}) {
+	m.t.Helper()
+	EqualsJSON(fatalTester{m.t}, expected, actual, msg...)
+}
+
+// PanicWith checks that f panics with a value equal to expected, or stops
+// the test.
+func (m *MustT) PanicWith(expected interface{}, f func(), msg ...interface{}) {
+	m.t.Helper()
+	PanicWith(fatalTester{m.t}, expected, f, msg...)
+}
+
+// PanicMatches checks that f panics with a value whose string
+// representation matches pattern, or stops the test.
+func (m *MustT) PanicMatches(pattern string, f func(), msg ...interface{}) {
+	m.t.Helper()
+	PanicMatches(fatalTester{m.t}, pattern, f, msg...)
+}
@@ -0,0 +1,92 @@
+package assert
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	tt := New(t)
+	if tt.T != t {
+		t.Fail()
+	}
+	if tt.Must == nil || tt.Must.t != t {
+		t.Fail()
+	}
+}
+
+func TestT_Checkers(t *testing.T) {
+	tt := New(t)
+
+	tt.True(true)
+	tt.False(false)
+	tt.NoError(nil)
+	tt.Error(errors.New("an error"))
+	tt.Equals(1, 1)
+	tt.NotEquals(1, 2)
+	tt.Nil(nil)
+	tt.NotNil(1)
+	tt.Len(3, []int{1, 2, 3})
+	tt.Panic(func() { panic("boom") })
+	tt.Type(1, 2)
+	tt.HasPrefix("foobar", "foo")
+	tt.Greater(2, 1)
+	tt.GreaterOrEqual(1, 1)
+	tt.Less(1, 2)
+	tt.LessOrEqual(1, 1)
+	tt.Between(0, 2, 1)
+	tt.InDelta(1.0, 1.0001, 0.01)
+	tt.InDuration(time.Unix(0, 0), time.Unix(0, 0), time.Second)
+	tt.Contains("foobar", "oob")
+	tt.NotContains("foobar", "baz")
+	tt.HasSuffix("foobar", "bar")
+	tt.Match("^foo", "foobar")
+	tt.SliceContains([]int{1, 2, 3}, 2)
+	tt.MapContainsKey(map[string]int{"foo": 1}, "foo")
+	tt.EqualsJSON(`{"a":1}`, `{"a":1}`)
+	tt.PanicWith("boom", func() { panic("boom") })
+	tt.PanicMatches("^boom", func() { panic("boom") })
+}
+
+func TestMustT_Checkers(t *testing.T) {
+	tt := New(t)
+
+	tt.Must.True(true)
+	tt.Must.False(false)
+	tt.Must.NoError(nil)
+	tt.Must.Error(errors.New("an error"))
+	tt.Must.Equals(1, 1)
+	tt.Must.NotEquals(1, 2)
+	tt.Must.Nil(nil)
+	tt.Must.NotNil(1)
+	tt.Must.Len(3, []int{1, 2, 3})
+	tt.Must.Panic(func() { panic("boom") })
+	tt.Must.Type(1, 2)
+	tt.Must.HasPrefix("foobar", "foo")
+	tt.Must.Greater(2, 1)
+	tt.Must.GreaterOrEqual(1, 1)
+	tt.Must.Less(1, 2)
+	tt.Must.LessOrEqual(1, 1)
+	tt.Must.Between(0, 2, 1)
+	tt.Must.InDelta(1.0, 1.0001, 0.01)
+	tt.Must.InDuration(time.Unix(0, 0), time.Unix(0, 0), time.Second)
+	tt.Must.Contains("foobar", "oob")
+	tt.Must.NotContains("foobar", "baz")
+	tt.Must.HasSuffix("foobar", "bar")
+	tt.Must.Match("^foo", "foobar")
+	tt.Must.SliceContains([]int{1, 2, 3}, 2)
+	tt.Must.MapContainsKey(map[string]int{"foo": 1}, "foo")
+	tt.Must.EqualsJSON(`{"a":1}`, `{"a":1}`)
+	tt.Must.PanicWith("boom", func() { panic("boom") })
+	tt.Must.PanicMatches("^boom", func() { panic("boom") })
+}
+
+func TestFatalTester(t *testing.T) {
+	inner := tt()
+	ft := fatalTester{inner}
+	ft.Errorf("boom")
+	if inner.method != "Fatalf" {
+		t.Fail()
+	}
+}
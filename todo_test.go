@@ -0,0 +1,74 @@
+package assert
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestTodo_LogsFailures(t *testing.T) {
+	ok := t.Run("sub", func(st *testing.T) {
+		todo := Todo(st)
+		if Equals(todo, 1, 2) {
+			st.Fail()
+		}
+	})
+	if !ok {
+		t.Fail()
+	}
+}
+
+// TestTODO_AllPass drives the "all assertions passed" path through a real
+// *testing.T so that t.Cleanup actually fires. Since that path legitimately
+// fails the subtest, it's driven from a subprocess so the failure it
+// verifies doesn't fail this test's own run.
+func TestTODO_AllPass(t *testing.T) {
+	if os.Getenv("ASSERT_TODO_ALLPASS_HELPER") == "1" {
+		t.Run("sub", func(st *testing.T) {
+			todo := New(st).TODO()
+			if !todo.Equals(1, 1) {
+				st.Fail()
+			}
+		})
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestTODO_AllPass/sub", "-test.v")
+	cmd.Env = append(os.Environ(), "ASSERT_TODO_ALLPASS_HELPER=1")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("expected the sub-test to fail with \"TODO passed unexpectedly\", but it passed:\n%s", out)
+	}
+	if !strings.Contains(string(out), "TODO passed unexpectedly") {
+		t.Fatalf("expected output to contain \"TODO passed unexpectedly\", got:\n%s", out)
+	}
+}
+
+// TestTodoT_Checkers drives TodoT through checkers beyond Equals to prove
+// New(st).TODO() forwards to the right underlying function, not just that
+// Errorf/Logf semantics work on the bare todoTester.
+func TestTodoT_Checkers(t *testing.T) {
+	ok := t.Run("sub", func(st *testing.T) {
+		todo := New(st).TODO()
+		if !todo.InDelta(1.0, 1.0001, 0.01) {
+			st.Fail()
+		}
+		if todo.Match("^bar", "foobar") {
+			st.Fail()
+		}
+	})
+	if !ok {
+		t.Fail()
+	}
+}
+
+func TestTodoTester(t *testing.T) {
+	inner := &testing.T{}
+	tt := &todoTester{t: inner}
+
+	tt.Errorf("boom")
+	if !tt.failed {
+		t.Fail()
+	}
+}